@@ -0,0 +1,49 @@
+// Команда import-workout разбирает файл с тренировками (FIT, GPX или TCX) и печатает ReadData
+// для каждой найденной активности.
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/Enotisi/go-first-floor-sprint-five/importer"
+	"github.com/Enotisi/go-first-floor-sprint-five/tracker"
+)
+
+func main() {
+	if len(os.Args) != 2 {
+		fmt.Fprintf(os.Stderr, "usage: %s <file.fit|file.gpx|file.tcx>\n", filepath.Base(os.Args[0]))
+		os.Exit(1)
+	}
+
+	path := os.Args[1]
+	file, err := os.Open(path)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	defer file.Close()
+
+	var activities []tracker.CaloriesCalculator
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".fit":
+		activities, err = importer.ParseFIT(file)
+	case ".gpx":
+		activities, err = importer.ParseGPX(file)
+	case ".tcx":
+		activities, err = importer.ParseTCX(file)
+	default:
+		err = fmt.Errorf("unsupported file extension %q", filepath.Ext(path))
+	}
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	for _, activity := range activities {
+		fmt.Println(tracker.ReadData(activity))
+	}
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Enotisi/go-first-floor-sprint-five/tracker"
+)
+
+func main() {
+
+	swimming := tracker.Swimming{
+		Training: tracker.Training{
+			TrainingType: "Плавание",
+			Action:       2000,
+			LenStep:      tracker.SwimmingLenStep,
+			Duration:     90 * time.Minute,
+			Weight:       85,
+		},
+		LengthPool: 50,
+		CountPool:  5,
+	}
+
+	fmt.Println(tracker.ReadData(swimming))
+
+	walking := tracker.Walking{
+		Training: tracker.Training{
+			TrainingType: "Ходьба",
+			Action:       20000,
+			LenStep:      tracker.LenStep,
+			Duration:     3*time.Hour + 45*time.Minute,
+			Weight:       85,
+		},
+		Height: 185,
+	}
+
+	fmt.Println(tracker.ReadData(walking))
+
+	running := tracker.Running{
+		Training: tracker.Training{
+			TrainingType: "Бег",
+			Action:       5000,
+			LenStep:      tracker.LenStep,
+			Duration:     30 * time.Minute,
+			Weight:       85,
+		},
+	}
+
+	fmt.Println(tracker.ReadData(running))
+
+}
@@ -0,0 +1,197 @@
+package importer
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/Enotisi/go-first-floor-sprint-five/tracker"
+)
+
+// Номера полей и глобальных сообщений, которые нам нужны из FIT SDK (Profile.xlsx): session (mesg 18),
+// поля total_distance (5, см), total_elapsed_time (7, мс), sport (5).
+const (
+	fitGlobalMesgSession = 18
+
+	fitSessionFieldSport         = 5
+	fitSessionFieldTotalElapsed  = 7
+	fitSessionFieldTotalDistance = 9
+)
+
+// ParseFIT разбирает FIT-файл и возвращает по одной тренировке на каждое найденное сообщение session.
+// Поддерживается только плоский (non-compressed-timestamp) формат записей и базовые поля session,
+// которых достаточно для суммарной дистанции/длительности/вида спорта — остальные сообщения (record,
+// lap, event и т.д.) пропускаются, поэтому лапы по FIT-файлам сейчас не возвращаются.
+func ParseFIT(r io.Reader) ([]tracker.CaloriesCalculator, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("importer: read fit: %w", err)
+	}
+
+	headerSize, err := fitHeaderSize(data)
+	if err != nil {
+		return nil, err
+	}
+
+	defs := map[byte]fitDefinition{}
+	var results []tracker.CaloriesCalculator
+
+	offset := headerSize
+	for offset < len(data)-2 { // оставляем 2 байта на CRC в конце файла
+		recordHeader := data[offset]
+		offset++
+
+		localType := recordHeader & 0x0F
+		isDefinition := recordHeader&0x40 != 0
+
+		if isDefinition {
+			def, size, err := parseFitDefinition(data[offset:])
+			if err != nil {
+				return nil, err
+			}
+			defs[localType] = def
+			offset += size
+			continue
+		}
+
+		def, ok := defs[localType]
+		if !ok {
+			return nil, fmt.Errorf("importer: parse fit: data record before definition (local type %d)", localType)
+		}
+
+		if offset+def.size > len(data) {
+			return nil, fmt.Errorf("importer: parse fit: truncated data record at offset %d", offset)
+		}
+
+		fields := data[offset : offset+def.size]
+		offset += def.size
+
+		if def.globalMesgNum == fitGlobalMesgSession {
+			activity, err := sessionToActivity(def, fields)
+			if err != nil {
+				return nil, err
+			}
+			results = append(results, activity)
+		}
+	}
+
+	return results, nil
+}
+
+// fitHeaderSize проверяет сигнатуру ".FIT" и возвращает размер заголовка.
+func fitHeaderSize(data []byte) (int, error) {
+	if len(data) < 12 {
+		return 0, fmt.Errorf("importer: parse fit: file too short")
+	}
+
+	headerSize := int(data[0])
+	if headerSize < 12 || len(data) < headerSize {
+		return 0, fmt.Errorf("importer: parse fit: invalid header size %d", headerSize)
+	}
+
+	if string(data[8:12]) != ".FIT" {
+		return 0, fmt.Errorf("importer: parse fit: missing .FIT signature")
+	}
+
+	return headerSize, nil
+}
+
+// fitFieldDef описывает одно поле в определении сообщения.
+type fitFieldDef struct {
+	num  byte
+	size byte
+}
+
+// fitDefinition описывает структуру сообщений конкретного локального типа.
+type fitDefinition struct {
+	globalMesgNum uint16
+	bigEndian     bool
+	fields        []fitFieldDef
+	size          int
+}
+
+// parseFitDefinition разбирает definition-запись, начиная сразу после байта заголовка записи.
+func parseFitDefinition(data []byte) (fitDefinition, int, error) {
+	if len(data) < 5 {
+		return fitDefinition{}, 0, fmt.Errorf("importer: parse fit: truncated definition")
+	}
+
+	bigEndian := data[1] == 1
+	order := binary.ByteOrder(binary.LittleEndian)
+	if bigEndian {
+		order = binary.BigEndian
+	}
+
+	globalMesgNum := order.Uint16(data[2:4])
+	numFields := int(data[4])
+
+	def := fitDefinition{globalMesgNum: globalMesgNum, bigEndian: bigEndian}
+	pos := 5
+	for i := 0; i < numFields; i++ {
+		if len(data) < pos+3 {
+			return fitDefinition{}, 0, fmt.Errorf("importer: parse fit: truncated field definition")
+		}
+		field := fitFieldDef{num: data[pos], size: data[pos+1]}
+		def.fields = append(def.fields, field)
+		def.size += int(field.size)
+		pos += 3
+	}
+
+	return def, pos, nil
+}
+
+// sessionToActivity превращает поля session-сообщения в тренировку.
+func sessionToActivity(def fitDefinition, fields []byte) (tracker.CaloriesCalculator, error) {
+	order := binary.ByteOrder(binary.LittleEndian)
+	if def.bigEndian {
+		order = binary.BigEndian
+	}
+
+	var distanceM, elapsedMs float64
+	var err error
+	sport := "running"
+
+	pos := 0
+	for _, field := range def.fields {
+		raw := fields[pos : pos+int(field.size)]
+		pos += int(field.size)
+
+		switch field.num {
+		case fitSessionFieldTotalDistance:
+			if len(raw) >= 4 {
+				distanceM = float64(order.Uint32(raw)) / 100
+			}
+		case fitSessionFieldTotalElapsed:
+			if len(raw) >= 4 {
+				elapsedMs = float64(order.Uint32(raw))
+			}
+		case fitSessionFieldSport:
+			if len(raw) >= 1 {
+				sport, err = fitSportName(raw[0])
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	return buildActivity(sport, distanceM/tracker.MInKm, time.Duration(elapsedMs)*time.Millisecond)
+}
+
+// fitSportName переводит числовой код поля sport (FIT SDK Profile.xlsx) в название вида спорта.
+// Для нераспознанного кода возвращает ошибку вместо того, чтобы молча считать его бегом.
+func fitSportName(code byte) (string, error) {
+	switch code {
+	case 1:
+		return "running", nil
+	case 2:
+		return "cycling", nil
+	case 5:
+		return "swimming", nil
+	case 11:
+		return "walking", nil
+	default:
+		return "", fmt.Errorf("importer: unsupported fit sport code %d", code)
+	}
+}
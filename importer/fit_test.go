@@ -0,0 +1,103 @@
+package importer
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+)
+
+// buildFIT assembles a minimal, valid FIT file containing a single session message with the given
+// sport code, distance (meters) and elapsed time, using the field layout ParseFIT understands.
+func buildFIT(t *testing.T, sportCode byte, distanceM float64, elapsedMs uint32) []byte {
+	t.Helper()
+
+	var body bytes.Buffer
+
+	// Definition record: local type 0, little-endian, global mesg 18 (session), 3 fields.
+	body.WriteByte(0x40)
+	body.Write([]byte{0, 0, 18, 0, 3})
+	body.Write([]byte{fitSessionFieldSport, 1, 0}) // field num, size, base type
+	body.Write([]byte{fitSessionFieldTotalElapsed, 4, 0})
+	body.Write([]byte{fitSessionFieldTotalDistance, 4, 0})
+
+	// Data record: local type 0.
+	body.WriteByte(0x00)
+	body.WriteByte(sportCode)
+	var buf [4]byte
+	binary.LittleEndian.PutUint32(buf[:], elapsedMs)
+	body.Write(buf[:])
+	binary.LittleEndian.PutUint32(buf[:], uint32(distanceM*100))
+	body.Write(buf[:])
+
+	var header bytes.Buffer
+	header.WriteByte(12) // header size
+	header.WriteByte(0)  // protocol version
+	header.Write([]byte{0, 0})
+	var dataSize [4]byte
+	binary.LittleEndian.PutUint32(dataSize[:], uint32(body.Len()))
+	header.Write(dataSize[:])
+	header.WriteString(".FIT")
+
+	var file bytes.Buffer
+	file.Write(header.Bytes())
+	file.Write(body.Bytes())
+	file.Write([]byte{0, 0}) // CRC, unchecked by ParseFIT
+
+	return file.Bytes()
+}
+
+func TestParseFIT_Running(t *testing.T) {
+	data := buildFIT(t, 1, 5000, 1_800_000)
+
+	activities, err := ParseFIT(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("ParseFIT() error = %v", err)
+	}
+	if len(activities) != 1 {
+		t.Fatalf("got %d activities, want 1", len(activities))
+	}
+
+	info := activities[0].TrainingInfo()
+	if info.TrainingType != "Бег" {
+		t.Errorf("TrainingType = %q, want Бег", info.TrainingType)
+	}
+	if info.Distance < 4.9 || info.Distance > 5.1 {
+		t.Errorf("Distance = %v, want ~5", info.Distance)
+	}
+}
+
+func TestParseFIT_Cycling(t *testing.T) {
+	data := buildFIT(t, 2, 10000, 1_800_000)
+
+	activities, err := ParseFIT(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("ParseFIT() error = %v", err)
+	}
+	if len(activities) != 1 {
+		t.Fatalf("got %d activities, want 1", len(activities))
+	}
+
+	if got, want := activities[0].TrainingInfo().TrainingType, "Велоспорт"; got != want {
+		t.Errorf("TrainingType = %q, want %q", got, want)
+	}
+}
+
+func TestParseFIT_UnsupportedSport(t *testing.T) {
+	data := buildFIT(t, 15, 5000, 1_800_000) // 15 = rowing, not in the known code set
+
+	if _, err := ParseFIT(bytes.NewReader(data)); err == nil {
+		t.Error("ParseFIT() error = nil, want error for unsupported sport code")
+	}
+}
+
+func TestParseFIT_TruncatedDataRecord(t *testing.T) {
+	data := buildFIT(t, 1, 5000, 1_800_000)
+
+	// Cut the file off partway through the data record's payload, after the header+definition+record
+	// header byte, so the declared field sizes no longer fit in the remaining buffer.
+	truncated := data[:len(data)-6]
+
+	if _, err := ParseFIT(bytes.NewReader(truncated)); err == nil {
+		t.Error("ParseFIT() error = nil, want error for a truncated data record")
+	}
+}
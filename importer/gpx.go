@@ -0,0 +1,100 @@
+package importer
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/Enotisi/go-first-floor-sprint-five/tracker"
+)
+
+type gpxFile struct {
+	Tracks []gpxTrack `xml:"trk"`
+}
+
+type gpxTrack struct {
+	Name     string       `xml:"name"`
+	Type     string       `xml:"type"`
+	Segments []gpxSegment `xml:"trkseg"`
+}
+
+type gpxSegment struct {
+	Points []gpxPoint `xml:"trkpt"`
+}
+
+type gpxPoint struct {
+	Lat  float64   `xml:"lat,attr"`
+	Lon  float64   `xml:"lon,attr"`
+	Time time.Time `xml:"time"`
+}
+
+// ParseGPX разбирает GPX-файл (один или несколько треков) в тренировки tracker.CaloriesCalculator.
+// Дистанция каждого трека считается по точкам (lat/lon), продолжительность — по меткам времени первой и
+// последней точки, каждый сегмент трека (trkseg) становится отдельным кругом в Activity.Laps.
+func ParseGPX(r io.Reader) ([]tracker.CaloriesCalculator, error) {
+	var file gpxFile
+	if err := xml.NewDecoder(r).Decode(&file); err != nil {
+		return nil, fmt.Errorf("importer: parse gpx: %w", err)
+	}
+
+	results := make([]tracker.CaloriesCalculator, 0, len(file.Tracks))
+	for _, track := range file.Tracks {
+		activity := &Activity{}
+		var totalDistance float64
+		var start, end time.Time
+
+		for _, segment := range track.Segments {
+			if len(segment.Points) == 0 {
+				continue
+			}
+
+			lapDistance := segmentDistanceKm(segment.Points)
+			lapStart := segment.Points[0].Time
+			lapEnd := segment.Points[len(segment.Points)-1].Time
+
+			if start.IsZero() || lapStart.Before(start) {
+				start = lapStart
+			}
+			if lapEnd.After(end) {
+				end = lapEnd
+			}
+
+			totalDistance += lapDistance
+			activity.Laps = append(activity.Laps, LapInfo{
+				Distance: lapDistance,
+				Duration: lapEnd.Sub(lapStart),
+			})
+		}
+
+		sport := track.Type
+		if sport == "" {
+			sport = track.Name
+		}
+
+		calc, err := buildActivity(sport, totalDistance, end.Sub(start))
+		if err != nil {
+			return nil, fmt.Errorf("importer: parse gpx: track %q: %w", track.Name, err)
+		}
+		activity.CaloriesCalculator = calc
+
+		if totalDistance > 0 {
+			for i := range activity.Laps {
+				activity.Laps[i].Calories = calc.Calories() * activity.Laps[i].Distance / totalDistance
+			}
+		}
+
+		results = append(results, activity)
+	}
+
+	return results, nil
+}
+
+// segmentDistanceKm суммирует дистанцию между последовательными точками сегмента трека.
+func segmentDistanceKm(points []gpxPoint) float64 {
+	var total float64
+	for i := 1; i < len(points); i++ {
+		total += haversineKm(points[i-1].Lat, points[i-1].Lon, points[i].Lat, points[i].Lon)
+	}
+	return total
+}
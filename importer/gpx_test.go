@@ -0,0 +1,63 @@
+package importer
+
+import (
+	"strings"
+	"testing"
+)
+
+const sampleGPX = `<?xml version="1.0"?>
+<gpx>
+  <trk>
+    <name>Morning Run</name>
+    <type>running</type>
+    <trkseg>
+      <trkpt lat="55.7558" lon="37.6173"><time>2026-01-01T07:00:00Z</time></trkpt>
+      <trkpt lat="55.7600" lon="37.6200"><time>2026-01-01T07:10:00Z</time></trkpt>
+    </trkseg>
+    <trkseg>
+      <trkpt lat="55.7600" lon="37.6200"><time>2026-01-01T07:10:00Z</time></trkpt>
+      <trkpt lat="55.7650" lon="37.6250"><time>2026-01-01T07:20:00Z</time></trkpt>
+    </trkseg>
+  </trk>
+</gpx>`
+
+func TestParseGPX_Laps(t *testing.T) {
+	activities, err := ParseGPX(strings.NewReader(sampleGPX))
+	if err != nil {
+		t.Fatalf("ParseGPX() error = %v", err)
+	}
+	if len(activities) != 1 {
+		t.Fatalf("got %d activities, want 1", len(activities))
+	}
+
+	info := activities[0].TrainingInfo()
+	if info.TrainingType != "Бег" {
+		t.Errorf("TrainingType = %q, want Бег", info.TrainingType)
+	}
+	if len(info.Laps) != 2 {
+		t.Fatalf("got %d laps, want 2", len(info.Laps))
+	}
+	for i, lap := range info.Laps {
+		if lap.Distance <= 0 {
+			t.Errorf("lap %d Distance = %v, want > 0", i, lap.Distance)
+		}
+	}
+}
+
+func TestParseGPX_UnsupportedSport(t *testing.T) {
+	const rowing = `<?xml version="1.0"?>
+<gpx>
+  <trk>
+    <name>Evening Row</name>
+    <type>rowing</type>
+    <trkseg>
+      <trkpt lat="0" lon="0"><time>2026-01-01T07:00:00Z</time></trkpt>
+      <trkpt lat="0.01" lon="0"><time>2026-01-01T07:10:00Z</time></trkpt>
+    </trkseg>
+  </trk>
+</gpx>`
+
+	if _, err := ParseGPX(strings.NewReader(rowing)); err == nil {
+		t.Error("ParseGPX() error = nil, want error for unsupported sport")
+	}
+}
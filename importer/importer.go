@@ -0,0 +1,155 @@
+// Package importer разбирает файлы с данными о тренировках (FIT, GPX, TCX)
+// в значения tracker.CaloriesCalculator, пригодные для ReadData.
+package importer
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/Enotisi/go-first-floor-sprint-five/tracker"
+)
+
+// DefaultWeight используется, когда формат файла не содержит веса пользователя.
+const DefaultWeight = 70
+
+// LapInfo содержит разбивку тренировки по отрезкам (кругам).
+type LapInfo struct {
+	Distance float64       // Дистанция круга, км
+	Duration time.Duration // Время круга
+	Calories float64       // Калории, потраченные за круг
+}
+
+// Activity оборачивает разобранную тренировку вместе с разбивкой по кругам.
+// Поскольку CaloriesCalculator встроен как интерфейс, Activity сама реализует CaloriesCalculator,
+// а вызывающий код, которому нужны круги, может привести результат к *Activity.
+type Activity struct {
+	tracker.CaloriesCalculator
+	Laps []LapInfo
+}
+
+// TrainingInfo возвращает InfoMessage встроенной тренировки, дополненную разбивкой по кругам,
+// чтобы ReadData/ReadDataAs могли напечатать круги без приведения к *Activity.
+// Это переопределенный метод TrainingInfo() встроенного CaloriesCalculator.
+func (a *Activity) TrainingInfo() tracker.InfoMessage {
+	info := a.CaloriesCalculator.TrainingInfo()
+
+	info.Laps = make([]tracker.LapInfo, len(a.Laps))
+	for i, lap := range a.Laps {
+		info.Laps[i] = tracker.LapInfo{
+			Distance: lap.Distance,
+			Duration: lap.Duration.Minutes(),
+			Calories: lap.Calories,
+		}
+	}
+
+	return info
+}
+
+// mapSport сопоставляет название вида спорта из файла с видом тренировки, который умеет считать tracker.
+// Для нераспознанного вида спорта возвращает ошибку вместо того, чтобы молча считать его бегом.
+func mapSport(sport string) (string, error) {
+	switch {
+	case contains(sport, "run"):
+		return tracker.TrainingTypeRunning, nil
+	case contains(sport, "walk", "hik"):
+		return tracker.TrainingTypeWalking, nil
+	case contains(sport, "swim"):
+		return tracker.TrainingTypeSwimming, nil
+	case contains(sport, "cycl", "bik"):
+		return tracker.TrainingTypeCycling, nil
+	default:
+		return "", fmt.Errorf("importer: unsupported sport %q", sport)
+	}
+}
+
+// contains сообщает, встречается ли в s (без учета регистра) хотя бы одна из подстрок.
+func contains(s string, substrings ...string) bool {
+	lower := strings.ToLower(s)
+	for _, sub := range substrings {
+		if strings.Contains(lower, sub) {
+			return true
+		}
+	}
+	return false
+}
+
+// buildActivity собирает tracker.CaloriesCalculator нужного типа по названию вида спорта,
+// пройденной дистанции (км) и продолжительности. Возвращает ошибку, если вид спорта не распознан.
+func buildActivity(sport string, distanceKm float64, duration time.Duration) (tracker.CaloriesCalculator, error) {
+	trainingType, err := mapSport(sport)
+	if err != nil {
+		return nil, err
+	}
+
+	switch trainingType {
+	case tracker.TrainingTypeSwimming:
+		return tracker.Swimming{
+			Training: tracker.Training{
+				TrainingType: trainingType,
+				Action:       stepsFor(distanceKm, tracker.SwimmingLenStep),
+				LenStep:      tracker.SwimmingLenStep,
+				Duration:     duration,
+				Weight:       DefaultWeight,
+			},
+			LengthPool: int(distanceKm * tracker.MInKm),
+			CountPool:  1,
+		}, nil
+	case tracker.TrainingTypeWalking:
+		return tracker.Walking{
+			Training: tracker.Training{
+				TrainingType: trainingType,
+				Action:       stepsFor(distanceKm, tracker.LenStep),
+				LenStep:      tracker.LenStep,
+				Duration:     duration,
+				Weight:       DefaultWeight,
+			},
+			Height: 175,
+		}, nil
+	case tracker.TrainingTypeCycling:
+		return tracker.Cycling{
+			Training: tracker.Training{
+				TrainingType: trainingType,
+				Action:       stepsFor(distanceKm, tracker.LenStep),
+				LenStep:      tracker.LenStep,
+				Duration:     duration,
+				Weight:       DefaultWeight,
+			},
+		}, nil
+	default:
+		return tracker.Running{
+			Training: tracker.Training{
+				TrainingType: trainingType,
+				Action:       stepsFor(distanceKm, tracker.LenStep),
+				LenStep:      tracker.LenStep,
+				Duration:     duration,
+				Weight:       DefaultWeight,
+			},
+		}, nil
+	}
+}
+
+// stepsFor переводит дистанцию в километрах в количество шагов/гребков указанной длины.
+func stepsFor(distanceKm, lenStep float64) int {
+	if lenStep == 0 {
+		return 0
+	}
+	return int(math.Round(distanceKm * tracker.MInKm / lenStep))
+}
+
+// haversineKm возвращает расстояние между двумя точками на сфере в километрах.
+func haversineKm(lat1, lon1, lat2, lon2 float64) float64 {
+	const earthRadiusKm = 6371.0
+
+	toRad := func(deg float64) float64 { return deg * math.Pi / 180 }
+
+	dLat := toRad(lat2 - lat1)
+	dLon := toRad(lon2 - lon1)
+
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(toRad(lat1))*math.Cos(toRad(lat2))*math.Sin(dLon/2)*math.Sin(dLon/2)
+	c := 2 * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
+
+	return earthRadiusKm * c
+}
@@ -0,0 +1,64 @@
+package importer
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/Enotisi/go-first-floor-sprint-five/tracker"
+)
+
+type tcxFile struct {
+	Activities []tcxActivity `xml:"Activities>Activity"`
+}
+
+type tcxActivity struct {
+	Sport string   `xml:"Sport,attr"`
+	Laps  []tcxLap `xml:"Lap"`
+}
+
+type tcxLap struct {
+	TotalTimeSeconds float64 `xml:"TotalTimeSeconds"`
+	DistanceMeters   float64 `xml:"DistanceMeters"`
+	Calories         float64 `xml:"Calories"`
+}
+
+// ParseTCX разбирает TCX-файл в тренировки tracker.CaloriesCalculator.
+// В отличие от GPX, TCX уже содержит готовую дистанцию и время на каждый круг (Lap),
+// поэтому они используются напрямую, без вычислений по точкам трека.
+func ParseTCX(r io.Reader) ([]tracker.CaloriesCalculator, error) {
+	var file tcxFile
+	if err := xml.NewDecoder(r).Decode(&file); err != nil {
+		return nil, fmt.Errorf("importer: parse tcx: %w", err)
+	}
+
+	results := make([]tracker.CaloriesCalculator, 0, len(file.Activities))
+	for _, rawActivity := range file.Activities {
+		var totalDistanceM, totalSeconds float64
+		laps := make([]LapInfo, 0, len(rawActivity.Laps))
+
+		for _, lap := range rawActivity.Laps {
+			totalDistanceM += lap.DistanceMeters
+			totalSeconds += lap.TotalTimeSeconds
+
+			laps = append(laps, LapInfo{
+				Distance: lap.DistanceMeters / tracker.MInKm,
+				Duration: time.Duration(lap.TotalTimeSeconds * float64(time.Second)),
+				Calories: lap.Calories,
+			})
+		}
+
+		calc, err := buildActivity(rawActivity.Sport, totalDistanceM/tracker.MInKm, time.Duration(totalSeconds*float64(time.Second)))
+		if err != nil {
+			return nil, fmt.Errorf("importer: parse tcx: activity %q: %w", rawActivity.Sport, err)
+		}
+
+		results = append(results, &Activity{
+			CaloriesCalculator: calc,
+			Laps:               laps,
+		})
+	}
+
+	return results, nil
+}
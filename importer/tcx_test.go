@@ -0,0 +1,64 @@
+package importer
+
+import (
+	"strings"
+	"testing"
+)
+
+const sampleTCX = `<?xml version="1.0"?>
+<TrainingCenterDatabase>
+  <Activities>
+    <Activity Sport="Biking">
+      <Lap>
+        <TotalTimeSeconds>600</TotalTimeSeconds>
+        <DistanceMeters>5000</DistanceMeters>
+        <Calories>150</Calories>
+      </Lap>
+      <Lap>
+        <TotalTimeSeconds>600</TotalTimeSeconds>
+        <DistanceMeters>5000</DistanceMeters>
+        <Calories>150</Calories>
+      </Lap>
+    </Activity>
+  </Activities>
+</TrainingCenterDatabase>`
+
+func TestParseTCX_Laps(t *testing.T) {
+	activities, err := ParseTCX(strings.NewReader(sampleTCX))
+	if err != nil {
+		t.Fatalf("ParseTCX() error = %v", err)
+	}
+	if len(activities) != 1 {
+		t.Fatalf("got %d activities, want 1", len(activities))
+	}
+
+	info := activities[0].TrainingInfo()
+	if info.TrainingType != "Велоспорт" {
+		t.Errorf("TrainingType = %q, want Велоспорт", info.TrainingType)
+	}
+	if len(info.Laps) != 2 {
+		t.Fatalf("got %d laps, want 2", len(info.Laps))
+	}
+	if info.Laps[0].Calories != 150 {
+		t.Errorf("Laps[0].Calories = %v, want 150", info.Laps[0].Calories)
+	}
+}
+
+func TestParseTCX_UnsupportedSport(t *testing.T) {
+	const rowing = `<?xml version="1.0"?>
+<TrainingCenterDatabase>
+  <Activities>
+    <Activity Sport="Rowing">
+      <Lap>
+        <TotalTimeSeconds>600</TotalTimeSeconds>
+        <DistanceMeters>1000</DistanceMeters>
+        <Calories>50</Calories>
+      </Lap>
+    </Activity>
+  </Activities>
+</TrainingCenterDatabase>`
+
+	if _, err := ParseTCX(strings.NewReader(rowing)); err == nil {
+		t.Error("ParseTCX() error = nil, want error for unsupported sport")
+	}
+}
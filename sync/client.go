@@ -0,0 +1,151 @@
+// Package sync тянет тренировки из удаленного HTTP API в локальные значения tracker.CaloriesCalculator.
+package sync
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/Enotisi/go-first-floor-sprint-five/tracker"
+)
+
+// Client клиент удаленного API тренировок.
+type Client struct {
+	BaseURL string                                      // Адрес API, например https://api.example.com
+	Tokens  TokenSource                                 // Источник токена авторизации
+	HTTPDo  func(*http.Request) (*http.Response, error) // Выполнение запроса, подменяется в тестах
+}
+
+// NewClient создает клиент с указанным адресом API и источником токена.
+// HTTPDo по умолчанию равен http.DefaultClient.Do.
+func NewClient(baseURL string, tokens TokenSource) *Client {
+	return &Client{
+		BaseURL: baseURL,
+		Tokens:  tokens,
+		HTTPDo:  http.DefaultClient.Do,
+	}
+}
+
+// remoteWorkout одна тренировка в ответе API.
+type remoteWorkout struct {
+	Type            string  `json:"type"`
+	DistanceMeters  float64 `json:"distance_meters"`
+	DurationSeconds float64 `json:"duration_seconds"`
+	WeightKg        float64 `json:"weight_kg"`
+}
+
+// workoutsPage одна страница ответа эндпоинта тренировок.
+type workoutsPage struct {
+	Workouts      []remoteWorkout `json:"workouts"`
+	NextPageToken string          `json:"next_page_token"`
+}
+
+// GetWorkouts постранично забирает тренировки за период [begin, end) и переводит их в tracker.CaloriesCalculator.
+func (c *Client) GetWorkouts(ctx context.Context, begin, end time.Time) ([]tracker.CaloriesCalculator, error) {
+	var results []tracker.CaloriesCalculator
+	pageToken := ""
+
+	for {
+		page, err := c.getWorkoutsPage(ctx, begin, end, pageToken)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, w := range page.Workouts {
+			activity, err := convertWorkout(w)
+			if err != nil {
+				return nil, err
+			}
+			results = append(results, activity)
+		}
+
+		if page.NextPageToken == "" {
+			return results, nil
+		}
+		pageToken = page.NextPageToken
+	}
+}
+
+// getWorkoutsPage выполняет один запрос к API и декодирует страницу ответа.
+func (c *Client) getWorkoutsPage(ctx context.Context, begin, end time.Time, pageToken string) (workoutsPage, error) {
+	token, err := c.Tokens.Token()
+	if err != nil {
+		return workoutsPage{}, fmt.Errorf("sync: get token: %w", err)
+	}
+
+	query := url.Values{}
+	query.Set("begin", begin.Format(time.RFC3339))
+	query.Set("end", end.Format(time.RFC3339))
+	if pageToken != "" {
+		query.Set("page_token", pageToken)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.BaseURL+"/workouts?"+query.Encode(), nil)
+	if err != nil {
+		return workoutsPage{}, fmt.Errorf("sync: build request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token.AccessToken)
+
+	resp, err := c.HTTPDo(req)
+	if err != nil {
+		return workoutsPage{}, fmt.Errorf("sync: do request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return workoutsPage{}, fmt.Errorf("sync: unexpected status %d", resp.StatusCode)
+	}
+
+	var page workoutsPage
+	if err := json.NewDecoder(resp.Body).Decode(&page); err != nil {
+		return workoutsPage{}, fmt.Errorf("sync: decode response: %w", err)
+	}
+
+	return page, nil
+}
+
+// convertWorkout переводит тренировку из ответа API в соответствующий конкретный тип tracker.
+// Для нераспознанного типа тренировки возвращает ошибку вместо того, чтобы молча считать ее бегом.
+func convertWorkout(w remoteWorkout) (tracker.CaloriesCalculator, error) {
+	duration := time.Duration(w.DurationSeconds * float64(time.Second))
+	lenStep := tracker.LenStep
+	var trainingType string
+
+	switch w.Type {
+	case "running":
+		trainingType = "Бег"
+	case "walking":
+		trainingType = "Ходьба"
+	case "swimming":
+		trainingType = "Плавание"
+		lenStep = tracker.SwimmingLenStep
+	default:
+		return nil, fmt.Errorf("sync: unsupported workout type %q", w.Type)
+	}
+
+	action := int(w.DistanceMeters / lenStep)
+
+	training := tracker.Training{
+		TrainingType: trainingType,
+		Action:       action,
+		LenStep:      lenStep,
+		Duration:     duration,
+		Weight:       w.WeightKg,
+	}
+
+	switch w.Type {
+	case "walking":
+		return tracker.Walking{Training: training, Height: 175}, nil
+	case "swimming":
+		return tracker.Swimming{
+			Training:   training,
+			LengthPool: int(w.DistanceMeters),
+			CountPool:  1,
+		}, nil
+	default:
+		return tracker.Running{Training: training}, nil
+	}
+}
@@ -0,0 +1,102 @@
+package sync
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestClient_GetWorkouts_PagesAndConverts(t *testing.T) {
+	pages := []workoutsPage{
+		{
+			Workouts: []remoteWorkout{
+				{Type: "running", DistanceMeters: 5000, DurationSeconds: 1800, WeightKg: 80},
+			},
+			NextPageToken: "page-2",
+		},
+		{
+			Workouts: []remoteWorkout{
+				{Type: "swimming", DistanceMeters: 1000, DurationSeconds: 2400, WeightKg: 80},
+			},
+		},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "Bearer test-token" {
+			t.Errorf("unexpected Authorization header: %q", got)
+		}
+
+		page := pages[0]
+		if r.URL.Query().Get("page_token") == "page-2" {
+			page = pages[1]
+		}
+
+		if err := json.NewEncoder(w).Encode(page); err != nil {
+			t.Fatalf("encode response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, StaticTokenSource("test-token"))
+	client.HTTPDo = http.DefaultClient.Do
+
+	begin := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := begin.Add(24 * time.Hour)
+
+	workouts, err := client.GetWorkouts(context.Background(), begin, end)
+	if err != nil {
+		t.Fatalf("GetWorkouts() error = %v", err)
+	}
+
+	if len(workouts) != 2 {
+		t.Fatalf("got %d workouts, want 2", len(workouts))
+	}
+
+	info := workouts[0].TrainingInfo()
+	if info.TrainingType != "Бег" {
+		t.Errorf("workouts[0].TrainingType = %q, want Бег", info.TrainingType)
+	}
+
+	info = workouts[1].TrainingInfo()
+	if info.TrainingType != "Плавание" {
+		t.Errorf("workouts[1].TrainingType = %q, want Плавание", info.TrainingType)
+	}
+}
+
+func TestClient_GetWorkouts_UnsupportedType(t *testing.T) {
+	page := workoutsPage{
+		Workouts: []remoteWorkout{
+			{Type: "cycling", DistanceMeters: 10000, DurationSeconds: 1800, WeightKg: 80},
+		},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewEncoder(w).Encode(page); err != nil {
+			t.Fatalf("encode response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, StaticTokenSource("test-token"))
+
+	if _, err := client.GetWorkouts(context.Background(), time.Now(), time.Now()); err == nil {
+		t.Error("GetWorkouts() error = nil, want error for unsupported workout type")
+	}
+}
+
+func TestClient_GetWorkouts_ErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, StaticTokenSource("test-token"))
+
+	_, err := client.GetWorkouts(context.Background(), time.Now(), time.Now())
+	if err == nil {
+		t.Fatal("GetWorkouts() error = nil, want error for non-200 status")
+	}
+}
@@ -0,0 +1,22 @@
+package sync
+
+import "time"
+
+// Token данные авторизации, полученные от TokenSource.
+type Token struct {
+	AccessToken string    // Значение токена для заголовка Authorization
+	ExpiresAt   time.Time // Момент истечения токена
+}
+
+// TokenSource поставляет токены авторизации для запросов к удаленному API.
+type TokenSource interface {
+	Token() (Token, error)
+}
+
+// StaticTokenSource простейший TokenSource, всегда возвращающий один и тот же токен без истечения срока.
+type StaticTokenSource string
+
+// Token возвращает статический токен.
+func (s StaticTokenSource) Token() (Token, error) {
+	return Token{AccessToken: string(s)}, nil
+}
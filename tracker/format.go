@@ -0,0 +1,151 @@
+package tracker
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// LapInfo содержит показатели одного круга (отрезка) тренировки для вывода в ReadDataAs.
+type LapInfo struct {
+	Distance float64 // Дистанция круга, км
+	Duration float64 // Длительность круга, мин
+	Calories float64 // Калории, потраченные за круг
+}
+
+// DecimalTimeToMinSec переводит дробные минуты в строку вида "M:SS", как принято для отображения темпа.
+func DecimalTimeToMinSec(totalMinutes float64) string {
+	if math.IsNaN(totalMinutes) || math.IsInf(totalMinutes, 0) || totalMinutes < 0 {
+		return "0:00"
+	}
+
+	minutes := int(totalMinutes)
+	seconds := int(math.Round((totalMinutes - float64(minutes)) * 60))
+	if seconds == 60 {
+		minutes++
+		seconds = 0
+	}
+
+	return fmt.Sprintf("%d:%02d", minutes, seconds)
+}
+
+// Pace возвращает темп тренировки в формате "M:SS". Для плавания темп считается на 100 м, для
+// остальных локомоционных тренировок — на километр. Для тренировок без дистанции возвращает "0:00".
+func (i InfoMessage) Pace() string {
+	if !i.HasDistance || i.Distance <= 0 {
+		return "0:00"
+	}
+
+	if i.TrainingType == TrainingTypeSwimming {
+		return DecimalTimeToMinSec(i.Duration.Minutes() / (i.Distance * MInKm / 100))
+	}
+
+	return DecimalTimeToMinSec(i.Duration.Minutes() / i.Distance)
+}
+
+// CaloriesPerKm возвращает расход калорий на километр дистанции, либо 0, если дистанции нет.
+func (i InfoMessage) CaloriesPerKm() float64 {
+	if !i.HasDistance || i.Distance <= 0 {
+		return 0
+	}
+
+	return i.Calories / i.Distance
+}
+
+// infoMessageJSON отражает InfoMessage в JSON/CSV-представлении, добавляя производные поля
+// (темп, калории на километр) и опуская поля, неприменимые к конкретному виду тренировки.
+type infoMessageJSON struct {
+	TrainingType  string             `json:"training_type"`
+	DurationMin   float64            `json:"duration_min"`
+	Calories      float64            `json:"calories"`
+	Distance      float64            `json:"distance_km,omitempty"`
+	Speed         float64            `json:"speed_kmh,omitempty"`
+	Pace          string             `json:"pace,omitempty"`
+	CaloriesPerKm float64            `json:"calories_per_km,omitempty"`
+	Sets          int                `json:"sets,omitempty"`
+	Reps          int                `json:"reps,omitempty"`
+	WeightLifted  float64            `json:"weight_lifted_kg,omitempty"`
+	ZoneMinutes   map[string]float64 `json:"zone_minutes,omitempty"`
+	Laps          []LapInfo          `json:"laps,omitempty"`
+}
+
+// toJSONView собирает представление InfoMessage, используемое MarshalJSON и CSVRecord.
+func (i InfoMessage) toJSONView() infoMessageJSON {
+	view := infoMessageJSON{
+		TrainingType: i.TrainingType,
+		DurationMin:  i.Duration.Minutes(),
+		Calories:     i.Calories,
+		ZoneMinutes:  i.ZoneMinutes,
+		Laps:         i.Laps,
+	}
+
+	if i.HasDistance {
+		view.Distance = i.Distance
+		view.Speed = i.Speed
+		view.Pace = i.Pace()
+		view.CaloriesPerKm = i.CaloriesPerKm()
+	} else {
+		view.Sets = i.Sets
+		view.Reps = i.Reps
+		view.WeightLifted = i.WeightLifted
+	}
+
+	return view
+}
+
+// MarshalJSON сериализует InfoMessage в JSON, дополняя его темпом и калориями на километр.
+func (i InfoMessage) MarshalJSON() ([]byte, error) {
+	return json.Marshal(i.toJSONView())
+}
+
+// CSVRecord возвращает InfoMessage в виде строки CSV-таблицы, с теми же полями, что и MarshalJSON.
+func (i InfoMessage) CSVRecord() []string {
+	view := i.toJSONView()
+
+	return []string{
+		view.TrainingType,
+		strconv.FormatFloat(view.DurationMin, 'f', 2, 64),
+		strconv.FormatFloat(view.Calories, 'f', 2, 64),
+		strconv.FormatFloat(view.Distance, 'f', 2, 64),
+		strconv.FormatFloat(view.Speed, 'f', 2, 64),
+		view.Pace,
+		strconv.FormatFloat(view.CaloriesPerKm, 'f', 2, 64),
+		strconv.Itoa(view.Sets),
+		strconv.Itoa(view.Reps),
+		strconv.FormatFloat(view.WeightLifted, 'f', 2, 64),
+	}
+}
+
+// ReadDataAs возвращает информацию о тренировке в указанном формате: "text" (по умолчанию, как ReadData),
+// "json" или "csv".
+func ReadDataAs(training CaloriesCalculator, format string) (string, error) {
+	info := training.TrainingInfo()
+	info.Calories = training.Calories()
+
+	switch format {
+	case "", "text":
+		return fmt.Sprint(info), nil
+	case "json":
+		data, err := info.MarshalJSON()
+		if err != nil {
+			return "", fmt.Errorf("tracker: marshal json: %w", err)
+		}
+		return string(data), nil
+	case "csv":
+		var sb strings.Builder
+		w := csv.NewWriter(&sb)
+		if err := w.Write(info.CSVRecord()); err != nil {
+			return "", fmt.Errorf("tracker: write csv: %w", err)
+		}
+		w.Flush()
+		if err := w.Error(); err != nil {
+			return "", fmt.Errorf("tracker: write csv: %w", err)
+		}
+		return sb.String(), nil
+	default:
+		return "", fmt.Errorf("tracker: unknown format %q", format)
+	}
+}
@@ -0,0 +1,131 @@
+package tracker
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDecimalTimeToMinSec(t *testing.T) {
+	cases := []struct {
+		minutes float64
+		want    string
+	}{
+		{0, "0:00"},
+		{5.5, "5:30"},
+		{9.999, "10:00"},
+		{-1, "0:00"},
+	}
+
+	for _, c := range cases {
+		if got := DecimalTimeToMinSec(c.minutes); got != c.want {
+			t.Errorf("DecimalTimeToMinSec(%v) = %q, want %q", c.minutes, got, c.want)
+		}
+	}
+}
+
+func TestInfoMessage_Pace(t *testing.T) {
+	running := InfoMessage{TrainingType: TrainingTypeRunning, HasDistance: true, Distance: 5, Duration: 25 * time.Minute}
+	if got, want := running.Pace(), "5:00"; got != want {
+		t.Errorf("running Pace() = %q, want %q", got, want)
+	}
+
+	swimming := InfoMessage{TrainingType: TrainingTypeSwimming, HasDistance: true, Distance: 1, Duration: 20 * time.Minute}
+	if got, want := swimming.Pace(), "2:00"; got != want {
+		t.Errorf("swimming Pace() = %q, want %q", got, want)
+	}
+
+	strength := InfoMessage{TrainingType: "Силовая тренировка", HasDistance: false}
+	if got, want := strength.Pace(), "0:00"; got != want {
+		t.Errorf("strength Pace() = %q, want %q", got, want)
+	}
+}
+
+func TestInfoMessage_CaloriesPerKm(t *testing.T) {
+	info := InfoMessage{HasDistance: true, Distance: 5, Calories: 250}
+	if got, want := info.CaloriesPerKm(), 50.0; got != want {
+		t.Errorf("CaloriesPerKm() = %v, want %v", got, want)
+	}
+
+	strength := InfoMessage{HasDistance: false, Calories: 100}
+	if got := strength.CaloriesPerKm(); got != 0 {
+		t.Errorf("CaloriesPerKm() = %v, want 0 for training without distance", got)
+	}
+}
+
+func TestInfoMessage_MarshalJSON(t *testing.T) {
+	info := InfoMessage{
+		TrainingType: TrainingTypeRunning,
+		HasDistance:  true,
+		Distance:     5,
+		Speed:        10,
+		Duration:     30 * time.Minute,
+		Calories:     300,
+	}
+
+	data, err := info.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON() error = %v", err)
+	}
+
+	var decoded map[string]any
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	if decoded["training_type"] != TrainingTypeRunning {
+		t.Errorf("training_type = %v, want %v", decoded["training_type"], TrainingTypeRunning)
+	}
+	if _, ok := decoded["pace"]; !ok {
+		t.Error("expected pace field in JSON output")
+	}
+}
+
+func TestInfoMessage_CSVRecord(t *testing.T) {
+	info := InfoMessage{
+		TrainingType: TrainingTypeRunning,
+		HasDistance:  true,
+		Distance:     5,
+		Speed:        10,
+		Duration:     30 * time.Minute,
+		Calories:     300,
+	}
+
+	record := info.CSVRecord()
+	if len(record) == 0 {
+		t.Fatal("CSVRecord() returned no fields")
+	}
+	if record[0] != TrainingTypeRunning {
+		t.Errorf("record[0] = %q, want %q", record[0], TrainingTypeRunning)
+	}
+}
+
+func TestReadDataAs(t *testing.T) {
+	r := Running{Training: Training{
+		TrainingType: TrainingTypeRunning,
+		Action:       5000,
+		LenStep:      LenStep,
+		Duration:     30 * time.Minute,
+		Weight:       85,
+	}}
+
+	text, err := ReadDataAs(r, "text")
+	if err != nil || !strings.Contains(text, TrainingTypeRunning) {
+		t.Errorf("ReadDataAs(text) = %q, err = %v", text, err)
+	}
+
+	jsonOut, err := ReadDataAs(r, "json")
+	if err != nil || !strings.Contains(jsonOut, `"training_type"`) {
+		t.Errorf("ReadDataAs(json) = %q, err = %v", jsonOut, err)
+	}
+
+	csvOut, err := ReadDataAs(r, "csv")
+	if err != nil || !strings.Contains(csvOut, TrainingTypeRunning) {
+		t.Errorf("ReadDataAs(csv) = %q, err = %v", csvOut, err)
+	}
+
+	if _, err := ReadDataAs(r, "xml"); err == nil {
+		t.Error("ReadDataAs(xml) error = nil, want error for unknown format")
+	}
+}
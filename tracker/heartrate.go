@@ -0,0 +1,131 @@
+package tracker
+
+import "time"
+
+// Названия пульсовых зон.
+const (
+	ZoneRest    = "Отдых"
+	ZoneFatBurn = "Жиросжигание"
+	ZoneCardio  = "Кардио"
+	ZonePeak    = "Пик"
+)
+
+// Коэффициенты расхода килокалорий в минуту на килограмм веса для каждой пульсовой зоны.
+const (
+	ZoneRestCaloriesPerMinPerKg    = 0.01
+	ZoneFatBurnCaloriesPerMinPerKg = 0.03
+	ZoneCardioCaloriesPerMinPerKg  = 0.06
+	ZonePeakCaloriesPerMinPerKg    = 0.1
+)
+
+// HRSample одно измерение пульса в конкретный момент тренировки.
+type HRSample struct {
+	At  time.Time // Момент измерения
+	BPM int       // Пульс, уд/мин
+}
+
+// HRZoneProfile границы пульсовых зон, рассчитанные по максимальному пульсу пользователя (220 - возраст).
+type HRZoneProfile struct {
+	Rest    int // Верхняя граница зоны отдыха
+	FatBurn int // Верхняя граница зоны жиросжигания
+	Cardio  int // Верхняя граница зоны кардио
+	Peak    int // Верхняя граница пиковой зоны (максимальный пульс)
+}
+
+// NewHRZoneProfile возвращает профиль пульсовых зон для пользователя указанного возраста.
+func NewHRZoneProfile(age int) HRZoneProfile {
+	max := 220 - age
+	return HRZoneProfile{
+		Rest:    max * 50 / 100,
+		FatBurn: max * 70 / 100,
+		Cardio:  max * 85 / 100,
+		Peak:    max,
+	}
+}
+
+// zoneFor возвращает название пульсовой зоны, в которую попадает указанный пульс.
+func (p HRZoneProfile) zoneFor(bpm int) string {
+	switch {
+	case bpm <= p.Rest:
+		return ZoneRest
+	case bpm <= p.FatBurn:
+		return ZoneFatBurn
+	case bpm <= p.Cardio:
+		return ZoneCardio
+	default:
+		return ZonePeak
+	}
+}
+
+// caloriesPerMinPerKg возвращает коэффициент расхода килокалорий для пульсовой зоны.
+func caloriesPerMinPerKg(zone string) float64 {
+	switch zone {
+	case ZoneRest:
+		return ZoneRestCaloriesPerMinPerKg
+	case ZoneFatBurn:
+		return ZoneFatBurnCaloriesPerMinPerKg
+	case ZoneCardio:
+		return ZoneCardioCaloriesPerMinPerKg
+	default:
+		return ZonePeakCaloriesPerMinPerKg
+	}
+}
+
+// HeartRateTraining тренировка, калорийность которой считается по замерам пульса, а не по средней скорости.
+type HeartRateTraining struct {
+	Training
+	Samples []HRSample // Замеры пульса за тренировку
+	Age     int        // Возраст пользователя
+}
+
+// zoneMinutes распределяет замеры пульса по зонам и возвращает время (в минутах), проведенное в каждой из них.
+// Продолжительность каждого замера считается как интервал до следующего замера; последний замер учитывается
+// до конца тренировки. Если метки времени замеров выходят за пределы Duration (например, устройство не
+// обрезало запись по концу тренировки), интервал не может быть отрицательным и обрезается до нуля.
+func (h HeartRateTraining) zoneMinutes() map[string]float64 {
+	minutes := map[string]float64{}
+	if len(h.Samples) == 0 {
+		return minutes
+	}
+
+	profile := NewHRZoneProfile(h.Age)
+	end := h.Samples[0].At.Add(h.Duration)
+
+	for i, sample := range h.Samples {
+		next := end
+		if i+1 < len(h.Samples) {
+			next = h.Samples[i+1].At
+		}
+
+		interval := next.Sub(sample.At)
+		if interval < 0 {
+			interval = 0
+		}
+
+		zone := profile.zoneFor(sample.BPM)
+		minutes[zone] += interval.Minutes()
+	}
+
+	return minutes
+}
+
+// Calories возвращает количество потраченных килокалорий на основе времени, проведенного в каждой пульсовой зоне.
+// Это переопределенный метод Calories() из Training.
+func (h HeartRateTraining) Calories() float64 {
+	var calories float64
+	for zone, minutes := range h.zoneMinutes() {
+		calories += minutes * caloriesPerMinPerKg(zone) * h.Weight
+	}
+
+	return calories
+}
+
+// TrainingInfo возвращает структуру InfoMessage с информацией о проведенной тренировке,
+// включая время, проведенное в каждой пульсовой зоне.
+// Это переопределенный метод TrainingInfo() из Training.
+func (h HeartRateTraining) TrainingInfo() InfoMessage {
+	info := h.Training.TrainingInfo()
+	info.ZoneMinutes = h.zoneMinutes()
+
+	return info
+}
@@ -0,0 +1,73 @@
+package tracker
+
+import (
+	"testing"
+	"time"
+)
+
+func TestHeartRateTraining_Calories(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	h := HeartRateTraining{
+		Training: Training{
+			TrainingType: "Бег",
+			Duration:     10 * time.Minute,
+			Weight:       80,
+		},
+		Age: 30,
+		Samples: []HRSample{
+			{At: start, BPM: 170}, // зона "Пик" на все 10 минут тренировки
+		},
+	}
+
+	want := 10 * ZonePeakCaloriesPerMinPerKg * 80.0
+	if got := h.Calories(); got != want {
+		t.Errorf("Calories() = %v, want %v", got, want)
+	}
+}
+
+func TestHeartRateTraining_Calories_SamplesOutlastDuration(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	h := HeartRateTraining{
+		Training: Training{
+			TrainingType: "Бег",
+			Duration:     10 * time.Minute,
+			Weight:       80,
+		},
+		Age: 30,
+		Samples: []HRSample{
+			{At: start, BPM: 170},
+			{At: start.Add(20 * time.Minute), BPM: 170}, // позже объявленной Duration
+		},
+	}
+
+	if got := h.Calories(); got < 0 {
+		t.Errorf("Calories() = %v, want >= 0", got)
+	}
+
+	minutes := h.zoneMinutes()
+	for zone, m := range minutes {
+		if m < 0 {
+			t.Errorf("zoneMinutes()[%q] = %v, want >= 0", zone, m)
+		}
+	}
+}
+
+func TestHeartRateTraining_TrainingInfo_ZoneMinutes(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	h := HeartRateTraining{
+		Training: Training{
+			TrainingType: "Бег",
+			Duration:     10 * time.Minute,
+			Weight:       80,
+		},
+		Age: 30,
+		Samples: []HRSample{
+			{At: start, BPM: 90}, // ниже границы зоны "Отдых" (190*50% = 95 при возрасте 30)
+		},
+	}
+
+	info := h.TrainingInfo()
+	if info.ZoneMinutes[ZoneRest] != 10 {
+		t.Errorf("ZoneMinutes[%q] = %v, want 10", ZoneRest, info.ZoneMinutes[ZoneRest])
+	}
+}
@@ -0,0 +1,152 @@
+package tracker
+
+import "fmt"
+
+// Названия встроенных формул расчета калорий.
+const (
+	FormulaDefault = "default" // формулы, повторяющие исходные расчеты Running/Walking/Swimming
+	FormulaMET     = "met"     // формулы на основе MET (metabolic equivalent of task)
+)
+
+// Средние значения MET, используемые формулами FormulaMET (см. Compendium of Physical Activities).
+const (
+	RunningMET  = 9.8
+	WalkingMET  = 3.5
+	SwimmingMET = 8.0
+	CyclingMET  = 7.5
+)
+
+// CalorieFormula считает количество потраченных килокалорий для вида тренировки trainingType.
+// extra несет данные, которых нет в Training (например, рост при ходьбе или скорость при плавании) —
+// конкретный смысл extra определяется вызывающим методом Calories().
+type CalorieFormula interface {
+	Compute(t Training, extra any) float64
+	Name() string
+}
+
+// registry хранит зарегистрированные формулы по виду тренировки и имени формулы.
+var registry = map[string]map[string]CalorieFormula{}
+
+// active хранит имя активной формулы для каждого вида тренировки.
+var active = map[string]string{}
+
+// Register добавляет формулу f в реестр для вида тренировки trainingType.
+// Первая зарегистрированная для trainingType формула становится активной по умолчанию.
+func Register(trainingType string, f CalorieFormula) {
+	if registry[trainingType] == nil {
+		registry[trainingType] = map[string]CalorieFormula{}
+	}
+	registry[trainingType][f.Name()] = f
+
+	if _, ok := active[trainingType]; !ok {
+		active[trainingType] = f.Name()
+	}
+}
+
+// SetActive делает формулу name активной для вида тренировки trainingType.
+func SetActive(trainingType, name string) error {
+	if _, ok := registry[trainingType][name]; !ok {
+		return fmt.Errorf("tracker: no formula %q registered for training type %q", name, trainingType)
+	}
+
+	active[trainingType] = name
+	return nil
+}
+
+// compute считает калории по активной для trainingType формуле.
+func compute(trainingType string, t Training, extra any) float64 {
+	f := registry[trainingType][active[trainingType]]
+	if f == nil {
+		return 0
+	}
+
+	return f.Compute(t, extra)
+}
+
+func init() {
+	Register(TrainingTypeRunning, runningDefaultFormula{})
+	Register(TrainingTypeRunning, runningMETFormula{})
+
+	Register(TrainingTypeWalking, walkingDefaultFormula{})
+	Register(TrainingTypeWalking, walkingMETFormula{})
+
+	Register(TrainingTypeSwimming, swimmingDefaultFormula{})
+	Register(TrainingTypeSwimming, swimmingMETFormula{})
+
+	Register(TrainingTypeCycling, cyclingMETFormula{})
+}
+
+// runningDefaultFormula повторяет исходную формулу Running.Calories().
+type runningDefaultFormula struct{}
+
+func (runningDefaultFormula) Name() string { return FormulaDefault }
+
+func (runningDefaultFormula) Compute(t Training, _ any) float64 {
+	speed := t.meanSpeed()
+	duration := t.Duration.Hours()
+	return (CaloriesMeanSpeedMultiplier*speed + CaloriesMeanSpeedShift) * t.Weight / MInKm * duration * MinInHours
+}
+
+// runningMETFormula считает калории бега по среднему MET.
+type runningMETFormula struct{}
+
+func (runningMETFormula) Name() string { return FormulaMET }
+
+func (runningMETFormula) Compute(t Training, _ any) float64 {
+	return RunningMET * t.Weight * t.Duration.Hours()
+}
+
+// walkingDefaultFormula повторяет исходную формулу Walking.Calories(). extra — рост пользователя в см.
+type walkingDefaultFormula struct{}
+
+func (walkingDefaultFormula) Name() string { return FormulaDefault }
+
+func (walkingDefaultFormula) Compute(t Training, extra any) float64 {
+	height, _ := extra.(float64)
+
+	speedMinSec := t.meanSpeed() * KmHInMsec
+	duration := t.Duration.Hours()
+	heightM := height / CmInM
+
+	return (CaloriesWeightMultiplier*t.Weight + (speedMinSec*speedMinSec/heightM)*CaloriesSpeedHeightMultiplier*t.Weight) * duration * MinInHours
+}
+
+// walkingMETFormula считает калории ходьбы по среднему MET.
+type walkingMETFormula struct{}
+
+func (walkingMETFormula) Name() string { return FormulaMET }
+
+func (walkingMETFormula) Compute(t Training, _ any) float64 {
+	return WalkingMET * t.Weight * t.Duration.Hours()
+}
+
+// swimmingDefaultFormula повторяет исходную формулу Swimming.Calories(). extra — скорость плавания, км/ч.
+type swimmingDefaultFormula struct{}
+
+func (swimmingDefaultFormula) Name() string { return FormulaDefault }
+
+func (swimmingDefaultFormula) Compute(t Training, extra any) float64 {
+	speed, _ := extra.(float64)
+	duration := t.Duration.Hours()
+
+	return (speed + SwimmingCaloriesMeanSpeedShift) * SwimmingCaloriesWeightMultiplier * t.Weight * duration
+}
+
+// swimmingMETFormula считает калории плавания по среднему MET.
+type swimmingMETFormula struct{}
+
+func (swimmingMETFormula) Name() string { return FormulaMET }
+
+func (swimmingMETFormula) Compute(t Training, _ any) float64 {
+	return SwimmingMET * t.Weight * t.Duration.Hours()
+}
+
+// cyclingMETFormula считает калории езды на велосипеде по среднему MET. Для велоспорта нет исходной
+// формулы на основе скорости, поэтому формула на MET зарегистрирована как единственная (и default).
+type cyclingMETFormula struct{}
+
+func (cyclingMETFormula) Name() string { return FormulaDefault }
+
+func (cyclingMETFormula) Compute(t Training, _ any) float64 {
+	return CyclingMET * t.Weight * t.Duration.Hours()
+}
@@ -0,0 +1,63 @@
+package tracker
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRegistry_DefaultFormulaMatchesLegacyCalculation(t *testing.T) {
+	r := Running{Training: Training{
+		TrainingType: TrainingTypeRunning,
+		Action:       5000,
+		LenStep:      LenStep,
+		Duration:     30 * time.Minute,
+		Weight:       85,
+	}}
+
+	speed := r.Training.meanSpeed()
+	want := (CaloriesMeanSpeedMultiplier*speed + CaloriesMeanSpeedShift) * r.Weight / MInKm * r.Duration.Hours() * MinInHours
+
+	if got := r.Calories(); got != want {
+		t.Errorf("Calories() = %v, want %v", got, want)
+	}
+}
+
+func TestSetActive_SwitchesFormula(t *testing.T) {
+	t.Cleanup(func() { _ = SetActive(TrainingTypeRunning, FormulaDefault) })
+
+	r := Running{Training: Training{
+		TrainingType: TrainingTypeRunning,
+		Action:       5000,
+		LenStep:      LenStep,
+		Duration:     time.Hour,
+		Weight:       80,
+	}}
+
+	defaultCalories := r.Calories()
+
+	if err := SetActive(TrainingTypeRunning, FormulaMET); err != nil {
+		t.Fatalf("SetActive() error = %v", err)
+	}
+
+	metCalories := r.Calories()
+	wantMET := RunningMET * r.Weight * r.Duration.Hours()
+
+	if metCalories != wantMET {
+		t.Errorf("Calories() with met formula = %v, want %v", metCalories, wantMET)
+	}
+	if metCalories == defaultCalories {
+		t.Errorf("Calories() did not change after switching formula")
+	}
+}
+
+func TestSetActive_UnknownFormula(t *testing.T) {
+	if err := SetActive(TrainingTypeRunning, "does-not-exist"); err == nil {
+		t.Error("SetActive() error = nil, want error for unknown formula name")
+	}
+}
+
+func TestCompute_NoFormulaRegistered(t *testing.T) {
+	if got := compute("unregistered-training-type", Training{Weight: 1, Duration: time.Hour}, nil); got != 0 {
+		t.Errorf("compute() = %v, want 0 for a training type with no registered formula", got)
+	}
+}
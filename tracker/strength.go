@@ -0,0 +1,38 @@
+package tracker
+
+import "time"
+
+// Константы для расчета потраченных килокалорий при силовой тренировке.
+const (
+	StrengthTrainingMET = 5 // среднее значение MET для силовых тренировок (подъем веса, упражнения с собственным весом)
+)
+
+// StrengthTraining структура, описывающая силовую тренировку (подъем веса, упражнения с собственным весом),
+// для которой неприменимы понятия дистанции и средней скорости.
+type StrengthTraining struct {
+	Training
+	Sets            int           // Кол-во подходов
+	Reps            int           // Кол-во повторений в подходе
+	WeightLifted    float64       // Вес снаряда, кг
+	RestBetweenSets time.Duration // Отдых между подходами
+}
+
+// Calories возвращает количество потраченных килокалорий при силовой тренировке.
+// Это переопределенный метод Calories() из Training, расчет ведется по формуле на основе MET.
+func (s StrengthTraining) Calories() float64 {
+	return StrengthTrainingMET * s.Weight * s.Duration.Hours()
+}
+
+// TrainingInfo возвращает структуру InfoMessage с информацией о проведенной тренировке.
+// Это переопределенный метод TrainingInfo() из Training: дистанция и скорость в нем не заполняются.
+func (s StrengthTraining) TrainingInfo() InfoMessage {
+	return InfoMessage{
+		TrainingType: s.TrainingType,
+		Duration:     s.Duration,
+		Calories:     s.Calories(),
+		HasDistance:  false,
+		Sets:         s.Sets,
+		Reps:         s.Reps,
+		WeightLifted: s.WeightLifted,
+	}
+}
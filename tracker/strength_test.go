@@ -0,0 +1,49 @@
+package tracker
+
+import (
+	"testing"
+	"time"
+)
+
+func TestStrengthTraining_Calories(t *testing.T) {
+	s := StrengthTraining{
+		Training: Training{
+			TrainingType: "Силовая тренировка",
+			Duration:     45 * time.Minute,
+			Weight:       80,
+		},
+		Sets:         4,
+		Reps:         10,
+		WeightLifted: 60,
+	}
+
+	want := StrengthTrainingMET * 80 * 0.75
+	if got := s.Calories(); got != want {
+		t.Errorf("Calories() = %v, want %v", got, want)
+	}
+}
+
+func TestStrengthTraining_TrainingInfo(t *testing.T) {
+	s := StrengthTraining{
+		Training: Training{
+			TrainingType: "Силовая тренировка",
+			Duration:     30 * time.Minute,
+			Weight:       70,
+		},
+		Sets:         3,
+		Reps:         12,
+		WeightLifted: 50,
+	}
+
+	info := s.TrainingInfo()
+
+	if info.HasDistance {
+		t.Error("HasDistance = true, want false for strength training")
+	}
+	if info.Sets != 3 || info.Reps != 12 || info.WeightLifted != 50 {
+		t.Errorf("info = %+v, want Sets=3 Reps=12 WeightLifted=50", info)
+	}
+	if info.Distance != 0 || info.Speed != 0 {
+		t.Errorf("info has non-zero Distance/Speed: %+v", info)
+	}
+}
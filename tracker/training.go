@@ -1,8 +1,8 @@
-package main
+// Package tracker содержит модели тренировок и расчет потраченных калорий.
+package tracker
 
 import (
 	"fmt"
-	"math"
 	"time"
 )
 
@@ -44,12 +44,20 @@ func (t Training) Calories() float64 {
 }
 
 // InfoMessage содержит информацию о проведенной тренировке.
+// HasDistance отличает локомоционные тренировки (бег, ходьба, плавание),
+// для которых известны дистанция и скорость, от силовых, где эти поля не имеют смысла.
 type InfoMessage struct {
-	TrainingType string        // Вид тренировки
-	Duration     time.Duration // Длительность тренировки
-	Distance     float64       // Рсстояние
-	Speed        float64       // Средняя скорость
-	Calories     float64       // Кол-во калорий
+	TrainingType string             // Вид тренировки
+	Duration     time.Duration      // Длительность тренировки
+	Distance     float64            // Рсстояние
+	Speed        float64            // Средняя скорость
+	Calories     float64            // Кол-во калорий
+	HasDistance  bool               // Есть ли у тренировки дистанция/скорость
+	Sets         int                // Кол-во подходов
+	Reps         int                // Кол-во повторений в подходе
+	WeightLifted float64            // Вес снаряда, кг
+	ZoneMinutes  map[string]float64 // Кол-во минут, проведенных в каждой пульсовой зоне
+	Laps         []LapInfo          // Разбивка тренировки по кругам (опционально, см. format.go)
 }
 
 // TrainingInfo возвращает труктуру InfoMessage, в которой хранится вся информация о проведенной тренировке.
@@ -60,18 +68,44 @@ func (t Training) TrainingInfo() InfoMessage {
 		Distance:     t.distance(),
 		Speed:        t.meanSpeed(),
 		Calories:     t.Calories(),
+		HasDistance:  true,
 	}
 }
 
 // String возвращает строку с информацией о проведенной тренировке.
 func (i InfoMessage) String() string {
-	return fmt.Sprintf("Тип тренировки: %s\nДлительность: %v мин\nДистанция: %.2f км.\nСр. скорость: %.2f км/ч\nПотрачено ккал: %.2f\n",
+	if !i.HasDistance {
+		return fmt.Sprintf("Тип тренировки: %s\nДлительность: %v мин\nПодходы: %d\nПовторения: %d\nВес снаряда: %.2f кг.\nПотрачено ккал: %.2f\n",
+			i.TrainingType,
+			i.Duration.Minutes(),
+			i.Sets,
+			i.Reps,
+			i.WeightLifted,
+			i.Calories,
+		)
+	}
+
+	result := fmt.Sprintf("Тип тренировки: %s\nДлительность: %v мин\nДистанция: %.2f км.\nСр. скорость: %.2f км/ч\nПотрачено ккал: %.2f\n",
 		i.TrainingType,
 		i.Duration.Minutes(),
 		i.Distance,
 		i.Speed,
 		i.Calories,
 	)
+
+	for _, zone := range []string{"Отдых", "Жиросжигание", "Кардио", "Пик"} {
+		minutes, ok := i.ZoneMinutes[zone]
+		if !ok {
+			continue
+		}
+		result += fmt.Sprintf("%s: %.1f мин\n", zone, minutes)
+	}
+
+	for n, lap := range i.Laps {
+		result += fmt.Sprintf("Круг %d: %.2f км, %.1f мин, %.2f ккал\n", n+1, lap.Distance, lap.Duration, lap.Calories)
+	}
+
+	return result
 }
 
 // CaloriesCalculator интерфейс для структур: Running, Walking и Swimming.
@@ -80,7 +114,15 @@ type CaloriesCalculator interface {
 	TrainingInfo() InfoMessage
 }
 
-// Константы для расчета потраченных килокалорий при беге.
+// Названия видов тренировок, под которыми формулы расчета калорий регистрируются в реестре (см. registry.go).
+const (
+	TrainingTypeRunning  = "Бег"
+	TrainingTypeWalking  = "Ходьба"
+	TrainingTypeSwimming = "Плавание"
+	TrainingTypeCycling  = "Велоспорт"
+)
+
+// Константы для расчета потраченных килокалорий при беге (формула по умолчанию, см. registry.go).
 const (
 	CaloriesMeanSpeedMultiplier = 18   // множитель средней скорости бега
 	CaloriesMeanSpeedShift      = 1.79 // коэффициент изменения средней скорости
@@ -92,11 +134,9 @@ type Running struct {
 }
 
 // Calories возввращает количество потраченных килокалория при беге.
-// Это переопределенный метод Calories() из Training.
+// Это переопределенный метод Calories() из Training, расчет делегируется активной формуле реестра (registry.go).
 func (r Running) Calories() float64 {
-	speed := r.meanSpeed()
-	duration := r.Duration.Hours()
-	return (CaloriesMeanSpeedMultiplier*speed + CaloriesMeanSpeedShift) * r.Weight / MInKm * duration * MinInHours
+	return compute(TrainingTypeRunning, r.Training, nil)
 }
 
 // TrainingInfo возвращает структуру InfoMessage с информацией о проведенной тренировке.
@@ -105,7 +145,24 @@ func (r Running) TrainingInfo() InfoMessage {
 	return r.Training.TrainingInfo()
 }
 
-// Константы для расчета потраченных килокалорий при ходьбе.
+// Cycling структура, описывающая тренировку Велоспорт.
+type Cycling struct {
+	Training
+}
+
+// Calories возвращает количество потраченных килокалорий при езде на велосипеде.
+// Это переопределенный метод Calories() из Training, расчет делегируется активной формуле реестра (registry.go).
+func (c Cycling) Calories() float64 {
+	return compute(TrainingTypeCycling, c.Training, nil)
+}
+
+// TrainingInfo возвращает структуру InfoMessage с информацией о проведенной тренировке.
+// Это переопределенный метод TrainingInfo() из Training.
+func (c Cycling) TrainingInfo() InfoMessage {
+	return c.Training.TrainingInfo()
+}
+
+// Константы для расчета потраченных килокалорий при ходьбе (формула по умолчанию, см. registry.go).
 const (
 	CaloriesWeightMultiplier      = 0.035 // коэффициент для веса
 	CaloriesSpeedHeightMultiplier = 0.029 // коэффициент для роста
@@ -119,12 +176,10 @@ type Walking struct {
 }
 
 // Calories возвращает количество потраченных килокалорий при ходьбе.
-// Это переопределенный метод Calories() из Training.
+// Это переопределенный метод Calories() из Training, расчет делегируется активной формуле реестра (registry.go).
+// Рост передается в формулу как extra, так как в Training ему нет места.
 func (w Walking) Calories() float64 {
-	speedMinSec := w.meanSpeed() * KmHInMsec
-	duration := w.Duration.Hours()
-	height := w.Height / CmInM
-	return (CaloriesWeightMultiplier*w.Weight + (math.Pow(speedMinSec, 2)/height)*CaloriesSpeedHeightMultiplier*w.Weight) * duration * MinInHours
+	return compute(TrainingTypeWalking, w.Training, w.Height)
 }
 
 // TrainingInfo возвращает структуру InfoMessage с информацией о проведенной тренировке.
@@ -133,7 +188,7 @@ func (w Walking) TrainingInfo() InfoMessage {
 	return w.Training.TrainingInfo()
 }
 
-// Константы для расчета потраченных килокалорий при плавании.
+// Константы для расчета потраченных килокалорий при плавании (формула по умолчанию, см. registry.go).
 const (
 	SwimmingLenStep                  = 1.38 // длина одного гребка
 	SwimmingCaloriesMeanSpeedShift   = 1.1  // коэффициент изменения средней скорости
@@ -159,12 +214,10 @@ func (s Swimming) meanSpeed() float64 {
 }
 
 // Calories возвращает количество калорий, потраченных при плавании.
-// Это переопределенный метод Calories() из Training.
+// Это переопределенный метод Calories() из Training, расчет делегируется активной формуле реестра (registry.go).
+// Скорость плавания передается в формулу как extra, так как она считается иначе, чем в Training.
 func (s Swimming) Calories() float64 {
-	speed := s.meanSpeed()
-	duration := s.Duration.Hours()
-
-	return (speed + SwimmingCaloriesMeanSpeedShift) * SwimmingCaloriesWeightMultiplier * s.Weight * duration
+	return compute(TrainingTypeSwimming, s.Training, s.meanSpeed())
 }
 
 // TrainingInfo returns info about swimming training.
@@ -188,46 +241,3 @@ func ReadData(training CaloriesCalculator) string {
 
 	return fmt.Sprint(info)
 }
-
-func main() {
-
-	swimming := Swimming{
-		Training: Training{
-			TrainingType: "Плавание",
-			Action:       2000,
-			LenStep:      SwimmingLenStep,
-			Duration:     90 * time.Minute,
-			Weight:       85,
-		},
-		LengthPool: 50,
-		CountPool:  5,
-	}
-
-	fmt.Println(ReadData(swimming))
-
-	walking := Walking{
-		Training: Training{
-			TrainingType: "Ходьба",
-			Action:       20000,
-			LenStep:      LenStep,
-			Duration:     3*time.Hour + 45*time.Minute,
-			Weight:       85,
-		},
-		Height: 185,
-	}
-
-	fmt.Println(ReadData(walking))
-
-	running := Running{
-		Training: Training{
-			TrainingType: "Бег",
-			Action:       5000,
-			LenStep:      LenStep,
-			Duration:     30 * time.Minute,
-			Weight:       85,
-		},
-	}
-
-	fmt.Println(ReadData(running))
-
-}